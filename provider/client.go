@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ErrNotFound is returned by DoJSON when the LiteLLM API responds with a
+// 404, so callers can treat the resource as gone (d.SetId("")) instead of
+// failing the read.
+var ErrNotFound = errors.New("litellm: resource not found")
+
+type LitellmClient struct {
+	ApiBaseURL string
+	ApiKey     string
+
+	cacheMu sync.RWMutex
+	cache   map[string]interface{}
+}
+
+func (c *LitellmClient) NewRequest(method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.ApiKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// DoJSON performs a request against path (relative to ApiBaseURL), JSON
+// encoding in (skipped if nil) and decoding the response into out (skipped
+// if nil). Non-2xx responses are returned as errors carrying the response
+// body; a 404 is returned as ErrNotFound so callers can SetId("") on read.
+func (c *LitellmClient) DoJSON(ctx context.Context, method, path string, in interface{}, out interface{}) error {
+	var bodyReader io.Reader
+	if in != nil {
+		encoded, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encoding request body for %s %s: %w", method, path, err)
+		}
+		bodyReader = bytes.NewBuffer(encoded)
+	}
+
+	req, err := c.NewRequest(method, fmt.Sprintf("%s%s", c.ApiBaseURL, path), bodyReader)
+	if err != nil {
+		return fmt.Errorf("building request for %s %s: %w", method, path, err)
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("performing request %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response body for %s %s: %w", method, path, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s returned status %d: %s", method, path, resp.StatusCode, string(respBody))
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("decoding response body for %s %s: %w", method, path, err)
+		}
+	}
+
+	return nil
+}
+
+// cacheKey namespaces a cache entry by kind (e.g. "team_info") and
+// identifier, so unrelated callers can't collide on the same key.
+func cacheKey(kind, key string) string {
+	return fmt.Sprintf("%s:%s", kind, key)
+}
+
+// GetCachedData returns the cached value for (kind, key), if any. The cache
+// lives on the client, which the provider hands to every resource instance
+// as its meta, so it is actually shared across the N resources touched by a
+// single plan/apply/refresh — unlike a context-scoped cache, which would be
+// reset on each CRUD callback's independent per-RPC context.
+func (c *LitellmClient) GetCachedData(kind, key string) (interface{}, bool) {
+	c.cacheMu.RLock()
+	defer c.cacheMu.RUnlock()
+
+	value, ok := c.cache[cacheKey(kind, key)]
+
+	return value, ok
+}
+
+// SetCachedData stores value under (kind, key).
+func (c *LitellmClient) SetCachedData(kind, key string, value interface{}) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	if c.cache == nil {
+		c.cache = make(map[string]interface{})
+	}
+	c.cache[cacheKey(kind, key)] = value
+}
+
+// RemoveCachedData evicts (kind, key) from the cache, e.g. after a write
+// that invalidates a previously cached read.
+func (c *LitellmClient) RemoveCachedData(kind, key string) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	delete(c.cache, cacheKey(kind, key))
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// teamMembershipRecord mirrors an entry of /team/info's team_memberships
+// array, which links a user to the budget backing their membership.
+type teamMembershipRecord struct {
+	UserId   string `json:"user_id"`
+	TeamId   string `json:"team_id"`
+	BudgetId string `json:"budget_id"`
+}
+
+func dataSourceTeam() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTeamRead,
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Team ID",
+			},
+			"spend": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Current spend for the team",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of models the team is allowed to access",
+			},
+			"metadata": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded metadata associated with the team",
+			},
+			"members_with_roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Members of the team and their role",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"team_memberships": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Per-member budgets, with budget_id resolved to max_budget",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"budget_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_budget": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "max_budget of the budget referenced by budget_id, if any",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*LitellmClient)
+
+	teamId := d.Get("team_id").(string)
+
+	var respJsonBody map[string]interface{}
+	path := fmt.Sprintf("/team/info?team_id=%s", teamId)
+	if err := client.DoJSON(ctx, "GET", path, nil, &respJsonBody); err != nil {
+		return diag.FromErr(fmt.Errorf("reading team %s: %w", teamId, err))
+	}
+
+	var membersWithRoles []litellm.MemberWithRole
+	if encoded, err := json.Marshal(respJsonBody["members_with_roles"]); err == nil {
+		if err := json.Unmarshal(encoded, &membersWithRoles); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var memberships []teamMembershipRecord
+	if encoded, err := json.Marshal(respJsonBody["team_memberships"]); err == nil {
+		if err := json.Unmarshal(encoded, &memberships); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resolvedBudgets, diags := resolveBudgets(ctx, client, memberships)
+	if diags != nil {
+		return diags
+	}
+
+	d.SetId(teamId)
+	d.Set("members_with_roles", flattenMembersWithRoles(membersWithRoles))
+	d.Set("team_memberships", flattenTeamMemberships(memberships, resolvedBudgets))
+
+	if v, ok := respJsonBody["spend"].(float64); ok {
+		d.Set("spend", v)
+	}
+	if v, ok := respJsonBody["models"].([]interface{}); ok {
+		d.Set("models", v)
+	}
+	if v, ok := respJsonBody["metadata"]; ok {
+		if encoded, err := json.Marshal(v); err == nil {
+			d.Set("metadata", string(encoded))
+		}
+	}
+
+	return nil
+}
+
+// resolveBudgets fetches /budget/info for every distinct budget_id
+// referenced by memberships, so it never issues more than one request per
+// budget no matter how many members share it.
+func resolveBudgets(ctx context.Context, client *LitellmClient, memberships []teamMembershipRecord) (map[string]float64, diag.Diagnostics) {
+	maxBudgets := make(map[string]float64)
+
+	for _, membership := range memberships {
+		if membership.BudgetId == "" {
+			continue
+		}
+		if _, ok := maxBudgets[membership.BudgetId]; ok {
+			continue
+		}
+
+		var budgetInfo map[string]interface{}
+		path := fmt.Sprintf("/budget/info?budget_id=%s", membership.BudgetId)
+		if err := client.DoJSON(ctx, "GET", path, nil, &budgetInfo); err != nil {
+			return nil, diag.FromErr(fmt.Errorf("resolving budget %s: %w", membership.BudgetId, err))
+		}
+
+		// A budget without a numeric max_budget is still recorded (as 0) so it
+		// is not fetched again for the next member sharing it.
+		maxBudget, _ := budgetInfo["max_budget"].(float64)
+		maxBudgets[membership.BudgetId] = maxBudget
+	}
+
+	return maxBudgets, nil
+}
+
+func flattenMembersWithRoles(members []litellm.MemberWithRole) []interface{} {
+	result := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		result = append(result, map[string]interface{}{
+			"user_id":    member.UserId,
+			"user_email": member.UserEmail,
+			"role":       member.Role,
+		})
+	}
+
+	return result
+}
+
+func flattenTeamMemberships(memberships []teamMembershipRecord, maxBudgets map[string]float64) []interface{} {
+	result := make([]interface{}, 0, len(memberships))
+	for _, membership := range memberships {
+		result = append(result, map[string]interface{}{
+			"user_id":    membership.UserId,
+			"budget_id":  membership.BudgetId,
+			"max_budget": maxBudgets[membership.BudgetId],
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTeamMembership() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTeamMembershipRead,
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Team ID",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "User ID",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Litellm Role of the user in the team",
+			},
+			"user_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"budget_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the budget backing this user's membership, if any",
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "max_budget of the budget referenced by budget_id, resolved via /budget/info",
+			},
+		},
+	}
+}
+
+func dataSourceTeamMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*LitellmClient)
+
+	teamId := d.Get("team_id").(string)
+	userId := d.Get("user_id").(string)
+
+	var respJsonBody map[string]interface{}
+	path := fmt.Sprintf("/team/info?team_id=%s", teamId)
+	if err := client.DoJSON(ctx, "GET", path, nil, &respJsonBody); err != nil {
+		return diag.FromErr(fmt.Errorf("reading team %s: %w", teamId, err))
+	}
+
+	var membersWithRoles []litellm.MemberWithRole
+	if encoded, err := json.Marshal(respJsonBody["members_with_roles"]); err == nil {
+		if err := json.Unmarshal(encoded, &membersWithRoles); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var member *litellm.MemberWithRole
+	for i := range membersWithRoles {
+		if membersWithRoles[i].UserId == userId {
+			member = &membersWithRoles[i]
+			break
+		}
+	}
+	if member == nil {
+		return diag.Errorf("user %s is not a member of team %s", userId, teamId)
+	}
+
+	var memberships []teamMembershipRecord
+	if encoded, err := json.Marshal(respJsonBody["team_memberships"]); err == nil {
+		if err := json.Unmarshal(encoded, &memberships); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var budgetId string
+	for _, membership := range memberships {
+		if membership.UserId == userId {
+			budgetId = membership.BudgetId
+			break
+		}
+	}
+
+	var maxBudget float64
+	if budgetId != "" {
+		var budgetInfo map[string]interface{}
+		path := fmt.Sprintf("/budget/info?budget_id=%s", budgetId)
+		if err := client.DoJSON(ctx, "GET", path, nil, &budgetInfo); err != nil {
+			return diag.FromErr(fmt.Errorf("resolving budget %s: %w", budgetId, err))
+		}
+		if v, ok := budgetInfo["max_budget"].(float64); ok {
+			maxBudget = v
+		}
+	}
+
+	d.SetId(teamMembershipId(teamId, userId))
+	d.Set("role", member.Role)
+	d.Set("user_email", member.UserEmail)
+	d.Set("budget_id", budgetId)
+	d.Set("max_budget", maxBudget)
+
+	return nil
+}
@@ -1,11 +1,11 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
+	"strings"
 
 	"github.com/gzamboni/terraform-provider-litellm/provider/litellm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -40,6 +40,11 @@ var SchemaTeamMembership = map[string]*schema.Schema{
 			return warns, errs
 		},
 	},
+	"user_email": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Email address of the user, as returned by LiteLLM",
+	},
 	// Max budget in theam doesnt anything for now (2024-11-15) so its better not to be set. When getting a team user are returned through the member_with_roles attribute, that doesn't include any mention of a budget so it doesnt work
 	// Users with a budget should be returned through the team_memberships but that's not the case
 	// "max_budget_in_team": {
@@ -56,28 +61,91 @@ func resourceTeamMembership() *schema.Resource {
 		ReadContext:   resourceTeamMembershipRead,
 		UpdateContext: resourceTeamMembershipUpdate,
 		DeleteContext: resourceTeamMembershipDelete,
-		Schema:        SchemaTeamMembership,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTeamMembershipImport,
+		},
+		Schema: SchemaTeamMembership,
 	}
 }
 
+// cacheKindTeamInfo is the GetCachedData/SetCachedData kind under which a
+// /team/info response is cached on the client, keyed by team_id.
+const cacheKindTeamInfo = "team_info"
+
+// teamMembershipId builds the composite ID used to track a team_membership
+// resource, since LiteLLM has no standalone membership identifier.
+func teamMembershipId(teamId, userId string) string {
+	return fmt.Sprintf("%s:%s", teamId, userId)
+}
+
+// parseTeamMembershipId splits a composite "<team_id>:<user_id>" ID. If the
+// ID isn't in that form (e.g. it hasn't been set yet), it falls back to the
+// team_id/user_id schema fields.
+func parseTeamMembershipId(d *schema.ResourceData) (teamId string, userId string, err error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	teamId = d.Get("team_id").(string)
+	userId = d.Get("user_id").(string)
+	if teamId == "" || userId == "" {
+		return "", "", fmt.Errorf("unable to determine team_id/user_id from id %q", d.Id())
+	}
+
+	return teamId, userId, nil
+}
+
+// getCachedTeamInfo returns the /team/info response for teamId, serving it
+// from the client's cache when a previous call already populated it. The
+// cache lives on client (shared across every resource instance for the
+// provider's lifetime), so once one litellm_team_membership read has fetched
+// a team_id, later reads of the same team_id reuse that response. Reads that
+// miss the cache at the same time each issue their own /team/info request.
+func getCachedTeamInfo(ctx context.Context, client *LitellmClient, teamId string) (map[string]interface{}, error) {
+	if cached, ok := client.GetCachedData(cacheKindTeamInfo, teamId); ok {
+		return cached.(map[string]interface{}), nil
+	}
+
+	var respJsonBody map[string]interface{}
+	path := fmt.Sprintf("/team/info?team_id=%s", teamId)
+	if err := client.DoJSON(ctx, "GET", path, nil, &respJsonBody); err != nil {
+		return nil, err
+	}
+
+	client.SetCachedData(cacheKindTeamInfo, teamId, respJsonBody)
+
+	return respJsonBody, nil
+}
+
+func resourceTeamMembershipImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	teamId, userId, err := parseTeamMembershipId(d)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamId)
+	d.Set("user_id", userId)
+	d.SetId(teamMembershipId(teamId, userId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 type TeamMembershipData struct {
-	UserId          string
-	Role            string
-	TeamId          string
-	MaxBudgetInTeam float64
+	UserId string
+	Role   string
+	TeamId string
 }
 
 func getTeamMembershipData(d *schema.ResourceData) TeamMembershipData {
 	userId := d.Get("user_id").(string)
 	role := d.Get("role").(string)
 	teamId := d.Get("team_id").(string)
-	maxBudgetInTeam := d.Get("max_budget_in_team").(float64)
 
 	return TeamMembershipData{
-		UserId:          userId,
-		Role:            role,
-		TeamId:          teamId,
-		MaxBudgetInTeam: maxBudgetInTeam,
+		UserId: userId,
+		Role:   role,
+		TeamId: teamId,
 	}
 }
 
@@ -87,7 +155,6 @@ func resourceTeamMembershipCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 
 	teamMembershipData := getTeamMembershipData(d)
-	apiUrl := fmt.Sprintf("%s/team/member_add", client.ApiBaseURL)
 
 	jsonPayload := map[string]interface{}{
 		"member": map[string]string{
@@ -96,28 +163,13 @@ func resourceTeamMembershipCreate(ctx context.Context, d *schema.ResourceData, m
 		},
 		"team_id": teamMembershipData.TeamId,
 	}
-	if teamMembershipData.MaxBudgetInTeam > 0.0 {
-		jsonPayload["max_budget_in_team"] = teamMembershipData.MaxBudgetInTeam
-	}
-	body, err := json.Marshal(jsonPayload)
-
-	if err != nil {
-		diag.FromErr(err)
-	}
-	req, err := client.NewRequest("POST", apiUrl, bytes.NewBuffer(body))
-	if err != nil {
-		diag.FromErr(err)
-	}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		diag.FromErr(err)
+	if err := client.DoJSON(ctx, "POST", "/team/member_add", jsonPayload, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("creating team membership: %w", err))
 	}
-	defer resp.Body.Close()
+	client.RemoveCachedData(cacheKindTeamInfo, teamMembershipData.TeamId)
 
-	if resp.StatusCode != http.StatusOK {
-		diag.Errorf("API request to create team membership has failed with status code %d", resp.StatusCode)
-	}
+	d.SetId(teamMembershipId(teamMembershipData.TeamId, teamMembershipData.UserId))
 
 	return diags
 }
@@ -127,44 +179,41 @@ func resourceTeamMembershipRead(ctx context.Context, d *schema.ResourceData, m i
 
 	var diags diag.Diagnostics
 
-	teamMembershipData := getTeamMembershipData(d)
-
-	apiUrl := fmt.Sprintf("%s/team/info?team_id=%s", client.ApiBaseURL, teamMembershipData.TeamId)
-	req, err := client.NewRequest("GET", apiUrl, nil)
+	teamId, userId, err := parseTeamMembershipId(d)
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	respJsonBody, err := getCachedTeamInfo(ctx, client, teamId)
 	if err != nil {
-		diag.FromErr(err)
-	}
-	defer resp.Body.Close()
-
-	var respJsonBody map[string]interface{}
-	err = json.NewDecoder(resp.Body).Decode(&respJsonBody)
-	if err != nil {
-		diag.FromErr(err)
+		if errors.Is(err, ErrNotFound) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("reading team membership: %w", err))
 	}
 
 	jsonTeamMembership, err := json.Marshal(respJsonBody["members_with_roles"])
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 	var membersWithRoles []litellm.MemberWithRole
-	err = json.Unmarshal(jsonTeamMembership, membersWithRoles)
-	if err != nil {
-		diag.FromErr(err)
+	if err := json.Unmarshal(jsonTeamMembership, &membersWithRoles); err != nil {
+		return diag.FromErr(err)
 	}
 
 	for _, teamMembership := range membersWithRoles {
-		if teamMembership.UserId == teamMembershipData.UserId {
+		if teamMembership.UserId == userId {
+			d.Set("team_id", teamId)
+			d.Set("user_id", userId)
 			d.Set("role", teamMembership.Role)
 			d.Set("user_email", teamMembership.UserEmail)
-			return nil
+			return diags
 		}
 	}
-	// Reponse .team_memberships.user_id .team_memberships.team_id .team_memberships.budget_id => budget.max_budget
+
+	// The user is no longer a member of the team.
+	d.SetId("")
 
 	return diags
 }
@@ -175,34 +224,17 @@ func resourceTeamMembershipUpdate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 
 	teamMembershipData := getTeamMembershipData(d)
-	apiUrl := fmt.Sprintf("%s/team/member_update", client.ApiBaseURL)
 
 	jsonPayload := map[string]interface{}{
 		"user_id": teamMembershipData.UserId,
 		"role":    teamMembershipData.Role,
 		"team_id": teamMembershipData.TeamId,
 	}
-	if teamMembershipData.MaxBudgetInTeam > 0.0 {
-		jsonPayload["max_budget_in_team"] = teamMembershipData.MaxBudgetInTeam
-	}
-	body, err := json.Marshal(jsonPayload)
-	if err != nil {
-		diag.FromErr(err)
-	}
-	req, err := client.NewRequest("POST", apiUrl, bytes.NewBuffer(body))
-	if err != nil {
-		diag.FromErr(err)
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		diag.FromErr(err)
-	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		diag.Errorf("API request to create team membership has failed with status code %d", resp.StatusCode)
+	if err := client.DoJSON(ctx, "POST", "/team/member_update", jsonPayload, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("updating team membership: %w", err))
 	}
+	client.RemoveCachedData(cacheKindTeamInfo, teamMembershipData.TeamId)
 
 	return diags
 }
@@ -213,30 +245,18 @@ func resourceTeamMembershipDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 
 	teamMembershipData := getTeamMembershipData(d)
-	apiUrl := fmt.Sprintf("%s/team/member_delete", client.ApiBaseURL)
 
-	jsonPayload, err := json.Marshal(map[string]interface{}{
+	jsonPayload := map[string]interface{}{
 		"user_id": teamMembershipData.UserId,
 		"team_id": teamMembershipData.TeamId,
-	})
-
-	if err != nil {
-		diag.FromErr(err)
-	}
-	req, err := client.NewRequest("POST", apiUrl, bytes.NewBuffer(jsonPayload))
-	if err != nil {
-		diag.FromErr(err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		diag.FromErr(err)
+	if err := client.DoJSON(ctx, "POST", "/team/member_delete", jsonPayload, nil); err != nil && !errors.Is(err, ErrNotFound) {
+		return diag.FromErr(fmt.Errorf("deleting team membership: %w", err))
 	}
-	defer resp.Body.Close()
+	client.RemoveCachedData(cacheKindTeamInfo, teamMembershipData.TeamId)
 
-	if resp.StatusCode != http.StatusOK {
-		diag.Errorf("API request to create team membership has failed with status code %d", resp.StatusCode)
-	}
+	d.SetId("")
 
 	return diags
 }
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	teamMembershipsModeAuthoritative = "authoritative"
+	teamMembershipsModeAdditive      = "additive"
+)
+
+var SchemaTeamMemberships = map[string]*schema.Schema{
+	"team_id": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Team ID",
+	},
+	"configuration_mode": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     teamMembershipsModeAuthoritative,
+		Description: "Whether members not declared in this resource are removed from the team (`authoritative`) or left untouched (`additive`)",
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			value := val.(string)
+			if value != teamMembershipsModeAuthoritative && value != teamMembershipsModeAdditive {
+				errs = append(errs, fmt.Errorf("configuration_mode must be one of [%s, %s], got %q", teamMembershipsModeAuthoritative, teamMembershipsModeAdditive, value))
+			}
+
+			return warns, errs
+		},
+	},
+	"member": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		Description: "Desired members of the team",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"user_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "User ID",
+				},
+				"role": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Litellm Role",
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						value := val.(string)
+						role, isValidated := litellm.ValidateRole(value)
+						if !isValidated {
+							errs = append(errs, fmt.Errorf("Provided role should be in this list %v", litellm.ROLE_LIST))
+						}
+						if role == litellm.PROXY_ADMIN || role == litellm.PROXY_ADMIN_VIEWER {
+							errs = append(errs, fmt.Errorf("proxy_admin and proxy_admin_viewer cannot be set to associate a team with an user"))
+						}
+
+						return warns, errs
+					},
+				},
+			},
+		},
+	},
+}
+
+func resourceTeamMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamMembershipsCreate,
+		ReadContext:   resourceTeamMembershipsRead,
+		UpdateContext: resourceTeamMembershipsUpdate,
+		DeleteContext: resourceTeamMembershipsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: SchemaTeamMemberships,
+	}
+}
+
+type teamMember struct {
+	UserId string
+	Role   string
+}
+
+func expandTeamMembers(raw interface{}) map[string]teamMember {
+	members := make(map[string]teamMember)
+	for _, v := range raw.(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		userId := m["user_id"].(string)
+		members[userId] = teamMember{
+			UserId: userId,
+			Role:   m["role"].(string),
+		}
+	}
+
+	return members
+}
+
+func flattenTeamMembers(members map[string]teamMember) []interface{} {
+	result := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		result = append(result, map[string]interface{}{
+			"user_id": member.UserId,
+			"role":    member.Role,
+		})
+	}
+
+	return result
+}
+
+// fetchTeamMembers fetches the current members_with_roles of a team,
+// sharing the same cached /team/info response as litellm_team_membership so
+// the two resources can't see different data for the same team_id within a
+// single refresh.
+func fetchTeamMembers(ctx context.Context, client *LitellmClient, teamId string) (map[string]teamMember, error) {
+	respJsonBody, err := getCachedTeamInfo(ctx, client, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(respJsonBody["members_with_roles"])
+	if err != nil {
+		return nil, err
+	}
+	var membersWithRoles []litellm.MemberWithRole
+	if err := json.Unmarshal(encoded, &membersWithRoles); err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]teamMember, len(membersWithRoles))
+	for _, m := range membersWithRoles {
+		members[m.UserId] = teamMember{UserId: m.UserId, Role: m.Role}
+	}
+
+	return members, nil
+}
+
+// syncTeamMembers reconciles the desired member set against the team's
+// current members, issuing the minimal set of add/update/delete calls.
+// In additive mode members present remotely but absent from the desired
+// set are left untouched.
+func syncTeamMembers(ctx context.Context, client *LitellmClient, teamId, mode string, desired map[string]teamMember) diag.Diagnostics {
+	current, err := fetchTeamMembers(ctx, client, teamId)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("reading current team members: %w", err))
+	}
+
+	wrote := false
+	defer func() {
+		if wrote {
+			client.RemoveCachedData(cacheKindTeamInfo, teamId)
+		}
+	}()
+
+	for userId, member := range desired {
+		existing, ok := current[userId]
+		if !ok {
+			payload := map[string]interface{}{
+				"team_id": teamId,
+				"member": map[string]string{
+					"user_id": member.UserId,
+					"role":    member.Role,
+				},
+			}
+			if err := client.DoJSON(ctx, "POST", "/team/member_add", payload, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("adding member %s to team %s: %w", userId, teamId, err))
+			}
+			wrote = true
+			continue
+		}
+
+		if existing.Role != member.Role {
+			payload := map[string]interface{}{
+				"team_id": teamId,
+				"user_id": member.UserId,
+				"role":    member.Role,
+			}
+			if err := client.DoJSON(ctx, "POST", "/team/member_update", payload, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("updating member %s in team %s: %w", userId, teamId, err))
+			}
+			wrote = true
+		}
+	}
+
+	if mode == teamMembershipsModeAuthoritative {
+		for userId := range current {
+			if _, ok := desired[userId]; ok {
+				continue
+			}
+			payload := map[string]interface{}{
+				"team_id": teamId,
+				"user_id": userId,
+			}
+			if err := client.DoJSON(ctx, "POST", "/team/member_delete", payload, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("removing member %s from team %s: %w", userId, teamId, err))
+			}
+			wrote = true
+		}
+	}
+
+	return nil
+}
+
+func resourceTeamMembershipsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*LitellmClient)
+
+	teamId := d.Get("team_id").(string)
+	mode := d.Get("configuration_mode").(string)
+	desired := expandTeamMembers(d.Get("member"))
+
+	if diags := syncTeamMembers(ctx, client, teamId, mode, desired); diags != nil {
+		return diags
+	}
+
+	d.SetId(teamId)
+
+	return resourceTeamMembershipsRead(ctx, d, m)
+}
+
+func resourceTeamMembershipsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*LitellmClient)
+
+	var diags diag.Diagnostics
+
+	teamId := d.Id()
+	mode := d.Get("configuration_mode").(string)
+
+	current, err := fetchTeamMembers(ctx, client, teamId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("reading team %s members: %w", teamId, err))
+	}
+
+	d.Set("team_id", teamId)
+
+	if mode == teamMembershipsModeAdditive {
+		desired := expandTeamMembers(d.Get("member"))
+		observed := make(map[string]teamMember, len(desired))
+		for userId := range desired {
+			if member, ok := current[userId]; ok {
+				observed[userId] = member
+			}
+		}
+		d.Set("member", flattenTeamMembers(observed))
+		return diags
+	}
+
+	d.Set("member", flattenTeamMembers(current))
+
+	return diags
+}
+
+func resourceTeamMembershipsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*LitellmClient)
+
+	teamId := d.Id()
+	mode := d.Get("configuration_mode").(string)
+	desired := expandTeamMembers(d.Get("member"))
+
+	if diags := syncTeamMembers(ctx, client, teamId, mode, desired); diags != nil {
+		return diags
+	}
+
+	return resourceTeamMembershipsRead(ctx, d, m)
+}
+
+func resourceTeamMembershipsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*LitellmClient)
+
+	var diags diag.Diagnostics
+
+	teamId := d.Id()
+	desired := expandTeamMembers(d.Get("member"))
+
+	for userId := range desired {
+		payload := map[string]interface{}{
+			"team_id": teamId,
+			"user_id": userId,
+		}
+		if err := client.DoJSON(ctx, "POST", "/team/member_delete", payload, nil); err != nil && !errors.Is(err, ErrNotFound) {
+			return diag.FromErr(fmt.Errorf("removing member %s from team %s: %w", userId, teamId, err))
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -2,39 +2,284 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
 
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func UserSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{}
+	return map[string]*schema.Schema{
+		"user_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Unique identifier for the user. Generated by LiteLLM if not provided",
+		},
+		"user_email": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Email address of the user",
+		},
+		"user_alias": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Friendly display name for the user",
+		},
+		"user_role": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Litellm Role. LiteLLM assigns a default role server-side when this is omitted",
+			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+				value := val.(string)
+				if _, isValidated := litellm.ValidateRole(value); !isValidated {
+					errs = append(errs, fmt.Errorf("Provided role should be in this list %v", litellm.ROLE_LIST))
+				}
+
+				return warns, errs
+			},
+		},
+		"teams": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "List of team IDs the user belongs to",
+		},
+		"models": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "List of models the user is allowed to access. An empty list means all models are allowed",
+		},
+		"max_budget": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Maximum budget allocated to the user",
+		},
+		"tpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Tokens per minute limit for the user",
+		},
+		"rpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Requests per minute limit for the user",
+		},
+		"budget_duration": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Duration after which the user's budget is reset, e.g. '30d', '1mo'",
+		},
+		"spend": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Current spend for the user",
+		},
+		"metadata": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "JSON-encoded metadata to associate with the user",
+			DiffSuppressFunc: metadataDiffSuppress,
+		},
+	}
+}
+
+// metadataDiffSuppress suppresses diffs on the metadata field that are only
+// due to LiteLLM augmenting the stored JSON with server-managed keys on
+// read. It ignores keys present in state but absent from the config, and
+// only reports a diff when a user-supplied key is missing or has changed.
+func metadataDiffSuppress(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if oldValue == newValue {
+		return true
+	}
+
+	var oldMetadata, newMetadata map[string]interface{}
+	if err := json.Unmarshal([]byte(oldValue), &oldMetadata); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(newValue), &newMetadata); err != nil {
+		return false
+	}
+
+	for key, newVal := range newMetadata {
+		oldVal, ok := oldMetadata[key]
+		if !ok || !reflect.DeepEqual(oldVal, newVal) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceModelCreate,
-		ReadContext:   resourceModelRead,
-		UpdateContext: resourceModelUpdate,
-		DeleteContext: resourceModelDelete,
-		Schema:        UserSchema(),
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: UserSchema(),
 	}
 }
 
-func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+type UserData struct {
+	UserId         string
+	UserEmail      string
+	UserAlias      string
+	UserRole       string
+	Teams          []string
+	Models         []string
+	MaxBudget      float64
+	TpmLimit       int
+	RpmLimit       int
+	BudgetDuration string
+	Metadata       string
+}
 
-	client := m.(*LitellmClient)
+func getUserData(d *schema.ResourceData) UserData {
+	teams := make([]string, 0)
+	for _, team := range d.Get("teams").([]interface{}) {
+		teams = append(teams, team.(string))
+	}
 
-	return diags
+	models := make([]string, 0)
+	for _, model := range d.Get("models").([]interface{}) {
+		models = append(models, model.(string))
+	}
+
+	return UserData{
+		UserId:         d.Get("user_id").(string),
+		UserEmail:      d.Get("user_email").(string),
+		UserAlias:      d.Get("user_alias").(string),
+		UserRole:       d.Get("user_role").(string),
+		Teams:          teams,
+		Models:         models,
+		MaxBudget:      d.Get("max_budget").(float64),
+		TpmLimit:       d.Get("tpm_limit").(int),
+		RpmLimit:       d.Get("rpm_limit").(int),
+		BudgetDuration: d.Get("budget_duration").(string),
+		Metadata:       d.Get("metadata").(string),
+	}
 }
 
-func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+// userCreatePayload builds the /user/new body from only the optionals the
+// config actually sets, mirroring userUpdatePayload below. Sending the rest
+// at their zero value (e.g. user_role:"") would override LiteLLM's
+// server-side defaults, which then wouldn't match the empty config on the
+// following Read and would produce a permanent diff.
+func userCreatePayload(d *schema.ResourceData, userData UserData) map[string]interface{} {
+	payload := map[string]interface{}{}
+
+	if userData.UserId != "" {
+		payload["user_id"] = userData.UserId
+	}
+	if _, ok := d.GetOk("user_email"); ok {
+		payload["user_email"] = userData.UserEmail
+	}
+	if _, ok := d.GetOk("user_alias"); ok {
+		payload["user_alias"] = userData.UserAlias
+	}
+	if _, ok := d.GetOk("user_role"); ok {
+		payload["user_role"] = userData.UserRole
+	}
+	if len(userData.Teams) > 0 {
+		payload["teams"] = userData.Teams
+	}
+	if len(userData.Models) > 0 {
+		payload["models"] = userData.Models
+	}
+	if _, ok := d.GetOk("max_budget"); ok {
+		payload["max_budget"] = userData.MaxBudget
+	}
+	if _, ok := d.GetOk("tpm_limit"); ok {
+		payload["tpm_limit"] = userData.TpmLimit
+	}
+	if _, ok := d.GetOk("rpm_limit"); ok {
+		payload["rpm_limit"] = userData.RpmLimit
+	}
+	if _, ok := d.GetOk("budget_duration"); ok {
+		payload["budget_duration"] = userData.BudgetDuration
+	}
+	if userData.Metadata != "" {
+		var metadata map[string]interface{}
+		if err := json.Unmarshal([]byte(userData.Metadata), &metadata); err == nil {
+			payload["metadata"] = metadata
+		}
+	}
+
+	return payload
+}
+
+// userUpdatePayload builds the /user/update body from only the fields that
+// actually changed, so an apply that leaves most of the config untouched
+// doesn't reset the rest to their zero values server-side.
+func userUpdatePayload(d *schema.ResourceData, userData UserData) map[string]interface{} {
+	payload := map[string]interface{}{
+		"user_id": userData.UserId,
+	}
+
+	if d.HasChange("user_email") {
+		payload["user_email"] = userData.UserEmail
+	}
+	if d.HasChange("user_alias") {
+		payload["user_alias"] = userData.UserAlias
+	}
+	if d.HasChange("user_role") {
+		payload["user_role"] = userData.UserRole
+	}
+	if d.HasChange("teams") {
+		payload["teams"] = userData.Teams
+	}
+	if d.HasChange("models") {
+		payload["models"] = userData.Models
+	}
+	if d.HasChange("max_budget") {
+		payload["max_budget"] = userData.MaxBudget
+	}
+	if d.HasChange("tpm_limit") {
+		payload["tpm_limit"] = userData.TpmLimit
+	}
+	if d.HasChange("rpm_limit") {
+		payload["rpm_limit"] = userData.RpmLimit
+	}
+	if d.HasChange("budget_duration") {
+		payload["budget_duration"] = userData.BudgetDuration
+	}
+	if d.HasChange("metadata") && userData.Metadata != "" {
+		var metadata map[string]interface{}
+		if err := json.Unmarshal([]byte(userData.Metadata), &metadata); err == nil {
+			payload["metadata"] = metadata
+		}
+	}
 
+	return payload
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*LitellmClient)
+	userData := getUserData(d)
 
-	return diags
+	var respJsonBody map[string]interface{}
+	if err := client.DoJSON(ctx, "POST", "/user/new", userCreatePayload(d, userData), &respJsonBody); err != nil {
+		return diag.FromErr(fmt.Errorf("creating user: %w", err))
+	}
+
+	userId, ok := respJsonBody["user_id"].(string)
+	if !ok || userId == "" {
+		userId = userData.UserId
+	}
+	d.SetId(userId)
+
+	return resourceUserRead(ctx, d, m)
 }
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -42,13 +287,89 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	client := m.(*LitellmClient)
 
+	var respJsonBody map[string]interface{}
+	path := fmt.Sprintf("/user/info?user_id=%s", d.Id())
+	if err := client.DoJSON(ctx, "GET", path, nil, &respJsonBody); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("reading user: %w", err))
+	}
+
+	userInfo, ok := respJsonBody["user_info"].(map[string]interface{})
+	if !ok {
+		userInfo = respJsonBody
+	}
+
+	if v, ok := userInfo["user_id"].(string); ok {
+		d.Set("user_id", v)
+	}
+	if v, ok := userInfo["user_email"].(string); ok {
+		d.Set("user_email", v)
+	}
+	if v, ok := userInfo["user_alias"].(string); ok {
+		d.Set("user_alias", v)
+	}
+	if v, ok := userInfo["user_role"].(string); ok {
+		d.Set("user_role", v)
+	}
+	if v, ok := userInfo["teams"].([]interface{}); ok {
+		d.Set("teams", v)
+	}
+	if v, ok := userInfo["models"].([]interface{}); ok {
+		d.Set("models", v)
+	}
+	if v, ok := userInfo["max_budget"].(float64); ok {
+		d.Set("max_budget", v)
+	}
+	if v, ok := userInfo["tpm_limit"].(float64); ok {
+		d.Set("tpm_limit", int(v))
+	}
+	if v, ok := userInfo["rpm_limit"].(float64); ok {
+		d.Set("rpm_limit", int(v))
+	}
+	if v, ok := userInfo["budget_duration"].(string); ok {
+		d.Set("budget_duration", v)
+	}
+	if v, ok := userInfo["spend"].(float64); ok {
+		d.Set("spend", v)
+	}
+	if v, ok := userInfo["metadata"]; ok {
+		if encoded, err := json.Marshal(v); err == nil {
+			d.Set("metadata", string(encoded))
+		}
+	}
+
 	return diags
 }
 
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*LitellmClient)
+	userData := getUserData(d)
+	userData.UserId = d.Id()
+
+	if err := client.DoJSON(ctx, "POST", "/user/update", userUpdatePayload(d, userData), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("updating user: %w", err))
+	}
+
+	return resourceUserRead(ctx, d, m)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	client := m.(*LitellmClient)
 
+	payload := map[string]interface{}{
+		"user_ids": []string{d.Id()},
+	}
+
+	if err := client.DoJSON(ctx, "POST", "/user/delete", payload, nil); err != nil && !errors.Is(err, ErrNotFound) {
+		return diag.FromErr(fmt.Errorf("deleting user: %w", err))
+	}
+
+	d.SetId("")
+
 	return diags
 }